@@ -0,0 +1,44 @@
+package retry
+
+import "time"
+
+// Clock provides the time source Retryer uses for MaxElapsedTime
+// bookkeeping and for waiting between attempts, so tests can
+// substitute a virtual time source instead of paying real delays.
+type Clock interface {
+	Now() time.Time
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer is the minimal shape of time.Timer that Retryer needs to wait
+// for a delay to elapse or the context to be done.
+type Timer interface {
+	C() <-chan time.Time
+	Stop() bool
+}
+
+// realClock is the default Clock, backed by the time package.
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) NewTimer(d time.Duration) Timer {
+	return realTimer{time.NewTimer(d)}
+}
+
+type realTimer struct {
+	t *time.Timer
+}
+
+func (t realTimer) C() <-chan time.Time {
+	return t.t.C
+}
+
+func (t realTimer) Stop() bool {
+	return t.t.Stop()
+}
+
+// defaultClock is used by Retryer when Clock is nil.
+var defaultClock Clock = realClock{}
@@ -0,0 +1,88 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// RetryAny runs each of ops concurrently, retrying each under its own
+// call to r.Retry, and returns as soon as one succeeds, canceling the
+// context passed to the rest. This lets callers hedge a request
+// across multiple replicas. If every op exhausts its retries, RetryAny
+// returns a RetryResult whose FinalOperationError joins the final
+// operation error of every op, via errors.Join.
+//
+// Each op runs its own copy of r, so if r.Delayer implements
+// Cloneable, e.g. DecorrelatedJitterDelayer, every op gets an
+// independent clone and the concurrent calls don't race on its
+// internal state. A stateful Delayer that does not implement
+// Cloneable must not be used with RetryAny.
+func RetryAny(ctx context.Context, r Retryer, ops ...func(context.Context) error) RetryResult {
+	if len(ops) == 0 {
+		return RetryResult{MaxAttempts: r.MaxAttempts}
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make(chan RetryResult, len(ops))
+	for _, op := range ops {
+		op := op
+		rop := r
+		if c, ok := rop.Delayer.(Cloneable); ok {
+			rop.Delayer = c.Clone()
+		}
+		go func() {
+			results <- rop.Retry(ctx, op)
+		}()
+	}
+
+	var errs []error
+	for i := 0; i < len(ops); i++ {
+		result := <-results
+		if result.FinalOperationError() == nil {
+			cancel()
+			return result
+		}
+		errs = append(errs, result.FinalOperationError())
+	}
+
+	return RetryResult{
+		MaxAttempts: r.MaxAttempts,
+		Attempts: []Attempt{
+			{OperationError: errors.Join(errs...)},
+		},
+	}
+}
+
+// RetryAll runs each of ops concurrently under its own call to
+// r.Retry, waits for all of them to finish, whether by success or by
+// exhausting their retries, and returns their RetryResults in the same
+// order as ops.
+//
+// Each op runs its own copy of r, so if r.Delayer implements
+// Cloneable, e.g. DecorrelatedJitterDelayer, every op gets an
+// independent clone and the concurrent calls don't race on its
+// internal state. A stateful Delayer that does not implement
+// Cloneable must not be used with RetryAll.
+func RetryAll(ctx context.Context, r Retryer, ops ...func(context.Context) error) []RetryResult {
+	results := make([]RetryResult, len(ops))
+
+	var wg sync.WaitGroup
+	wg.Add(len(ops))
+	for i, op := range ops {
+		i, op := i, op
+		rop := r
+		if c, ok := rop.Delayer.(Cloneable); ok {
+			rop.Delayer = c.Clone()
+		}
+		go func() {
+			defer wg.Done()
+			results[i] = rop.Retry(ctx, op)
+		}()
+	}
+	wg.Wait()
+
+	return results
+}
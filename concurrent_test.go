@@ -0,0 +1,96 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestRetryAny(t *testing.T) {
+	t.Run("winner", func(t *testing.T) {
+		retryer := Retryer{Delayer: NopDelayer{}, MaxAttempts: 3}
+		result := RetryAny(
+			context.Background(),
+			retryer,
+			func(context.Context) error { return errors.New("slow") },
+			func(context.Context) error { return nil },
+		)
+		if err := result.FinalOperationError(); err != nil {
+			t.Fatalf("want nil but get %v", err)
+		}
+	})
+
+	t.Run("all fail", func(t *testing.T) {
+		retryer := Retryer{Delayer: NopDelayer{}, MaxAttempts: 1}
+		err1 := errors.New("err1")
+		err2 := errors.New("err2")
+		result := RetryAny(
+			context.Background(),
+			retryer,
+			func(context.Context) error { return err1 },
+			func(context.Context) error { return err2 },
+		)
+		err := result.FinalOperationError()
+		if !errors.Is(err, err1) || !errors.Is(err, err2) {
+			t.Fatalf("want error joining %v and %v but get %v", err1, err2, err)
+		}
+	})
+
+	t.Run("no ops", func(t *testing.T) {
+		retryer := Retryer{Delayer: NopDelayer{}, MaxAttempts: 1}
+		result := RetryAny(context.Background(), retryer)
+		if err := result.FinalOperationError(); err != nil {
+			t.Fatalf("want nil but get %v", err)
+		}
+	})
+}
+
+func TestRetryAll(t *testing.T) {
+	retryer := Retryer{Delayer: NopDelayer{}, MaxAttempts: 1}
+	err1 := errors.New("err1")
+	results := RetryAll(
+		context.Background(),
+		retryer,
+		func(context.Context) error { return nil },
+		func(context.Context) error { return err1 },
+	)
+	if len(results) != 2 {
+		t.Fatalf("want 2 results but get %d", len(results))
+	}
+	if err := results[0].FinalOperationError(); err != nil {
+		t.Fatalf("want results[0] nil but get %v", err)
+	}
+	if err := results[1].FinalOperationError(); !errors.Is(err, err1) {
+		t.Fatalf("want results[1] %v but get %v", err1, err)
+	}
+}
+
+// TestRetryAllClonesStatefulDelayer guards against ops racing on a
+// shared DecorrelatedJitterDelayer's internal state; run with -race.
+func TestRetryAllClonesStatefulDelayer(t *testing.T) {
+	retryer := Retryer{
+		Delayer: &DecorrelatedJitterDelayer{
+			Min: time.Microsecond,
+			Max: 10 * time.Microsecond,
+		},
+		MaxAttempts: 5,
+	}
+	ops := make([]func(context.Context) error, 8)
+	for i := range ops {
+		attempts := 0
+		ops[i] = func(context.Context) error {
+			attempts++
+			if attempts < 3 {
+				return errors.New("not yet")
+			}
+			return nil
+		}
+	}
+	results := RetryAll(context.Background(), retryer, ops...)
+	for i, result := range results {
+		if err := result.FinalOperationError(); err != nil {
+			t.Fatalf("results[%d]: want nil but get %v", i, err)
+		}
+	}
+}
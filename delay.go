@@ -55,6 +55,10 @@ type ExpDelayer struct {
 	// If Rand greater than 100, 100 will be used.
 	// If Rand greater than 0, the delay may greater than Max.
 	Rand uint8
+	// RandSource, if non-nil, is used instead of the math/rand
+	// package-level functions to draw the variation, which allows
+	// deterministic tests.
+	RandSource *rand.Rand
 }
 
 // Default values for ExpDelayer.
@@ -105,6 +109,13 @@ func (d ExpDelayer) delay(attempt int) time.Duration {
 	return time.Duration(n)
 }
 
+func (d ExpDelayer) float64() float64 {
+	if d.RandSource != nil {
+		return d.RandSource.Float64()
+	}
+	return rand.Float64()
+}
+
 func (d ExpDelayer) rand(b time.Duration) time.Duration {
 	per := d.percent()
 	if per == 0 {
@@ -115,7 +126,7 @@ func (d ExpDelayer) rand(b time.Duration) time.Duration {
 	delta := float64(per) / 100 * bf
 	min := bf - delta
 	max := bf + delta
-	delay := time.Duration(min + (rand.Float64() * (max - min + 1)))
+	delay := time.Duration(min + (d.float64() * (max - min + 1)))
 	if delay < 0 {
 		return math.MaxInt64
 	}
@@ -129,3 +140,133 @@ func (d ExpDelayer) Delay(attempt int) time.Duration {
 
 	return d.rand(d.delay(attempt))
 }
+
+// Resettable is implemented by a Delayer that keeps state across calls
+// to Delay and needs that state cleared at the start of a Retry call,
+// such as DecorrelatedJitterDelayer. Retryer.Retry calls Reset before
+// the first attempt if the configured Delayer implements Resettable.
+type Resettable interface {
+	Reset()
+}
+
+// Cloneable is implemented by a Delayer that keeps per-invocation state
+// and can produce an independent copy of itself, such as
+// DecorrelatedJitterDelayer. RetryAny and RetryAll run r.Retry
+// concurrently for several operations; if r.Delayer implements
+// Cloneable, each operation gets its own clone so the concurrent calls
+// don't race on the shared state.
+type Cloneable interface {
+	Clone() Delayer
+}
+
+// Default values for DecorrelatedJitterDelayer.
+const (
+	DecorrelatedJitterInitial    = 500 * time.Millisecond
+	DecorrelatedJitterMultiplier = 3.0
+)
+
+// DecorrelatedJitterDelayer provides the "decorrelated jitter" backoff
+// popularized by AWS: each delay is drawn uniformly from [Min, prev*Multiplier]
+// and capped at Max, where prev is the delay returned by the previous
+// call and is seeded to Min on the first attempt. Because the
+// recurrence depends on prev, a *DecorrelatedJitterDelayer must not be
+// shared between concurrent Retry calls; use a separate instance per
+// Retryer, or rely on Retryer.Retry calling Reset for you between runs.
+// It implements Cloneable, so RetryAny and RetryAll give each
+// concurrent operation its own copy automatically.
+//
+// Compared to ExpDelayer, the decorrelated jitter spreads retries out
+// more evenly and clusters less under contention, making it a good
+// default when retrying against a shared or rate-limited backend.
+type DecorrelatedJitterDelayer struct {
+	// Min is the minimum delay, and the delay used to seed prev before
+	// the first attempt.
+	// If Min less than or equals to 0, DecorrelatedJitterInitial will be used.
+	Min time.Duration
+	// Max is the maximum delay.
+	// If Max less than or equals to 0, math.MaxInt64 will be used.
+	Max time.Duration
+	// Multiplier bounds how far the next delay can grow past prev.
+	// If Multiplier is less than 1, DecorrelatedJitterMultiplier will be used.
+	Multiplier float64
+	// Rand, if non-nil, is used instead of the math/rand package-level
+	// functions, which allows deterministic tests.
+	Rand *rand.Rand
+
+	prev time.Duration
+}
+
+func (d *DecorrelatedJitterDelayer) min() time.Duration {
+	if d.Min <= 0 {
+		return DecorrelatedJitterInitial
+	}
+	return d.Min
+}
+
+func (d *DecorrelatedJitterDelayer) max() time.Duration {
+	if d.Max > 0 {
+		return d.Max
+	}
+	return math.MaxInt64
+}
+
+func (d *DecorrelatedJitterDelayer) multiplier() float64 {
+	if d.Multiplier < 1 || math.IsNaN(d.Multiplier) || math.IsInf(d.Multiplier, 0) {
+		return DecorrelatedJitterMultiplier
+	}
+	return d.Multiplier
+}
+
+func (d *DecorrelatedJitterDelayer) float64() float64 {
+	if d.Rand != nil {
+		return d.Rand.Float64()
+	}
+	return rand.Float64()
+}
+
+// Reset clears the delayer's internal state, seeding the next call to
+// Delay as if it were the first attempt.
+func (d *DecorrelatedJitterDelayer) Reset() {
+	d.prev = 0
+}
+
+// Clone returns a copy of d with its internal state cleared, so the
+// copy can be used independently, e.g. by a concurrent Retry call.
+func (d *DecorrelatedJitterDelayer) Clone() Delayer {
+	clone := *d
+	clone.prev = 0
+	return &clone
+}
+
+func (d *DecorrelatedJitterDelayer) Delay(attempt int) time.Duration {
+	if attempt <= 0 {
+		return 0
+	}
+
+	if attempt == 1 {
+		d.prev = 0
+	}
+
+	min := d.min()
+	prev := d.prev
+	if prev <= 0 {
+		prev = min
+	}
+
+	upper := float64(prev) * d.multiplier()
+	if upper < float64(min) {
+		upper = float64(min)
+	}
+
+	n := float64(min) + d.float64()*(upper-float64(min))
+	delay := time.Duration(n)
+	if delay <= 0 {
+		delay = d.max()
+	}
+	if max := d.max(); delay > max {
+		delay = max
+	}
+
+	d.prev = delay
+	return delay
+}
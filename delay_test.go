@@ -12,6 +12,7 @@ func TestDelayerAttemptLTEZero(t *testing.T) {
 		NopDelayer{},
 		ConstantDelayer{Duration: time.Second},
 		ExpDelayer{},
+		&DecorrelatedJitterDelayer{},
 	}
 	for i := 0; i < 10; i++ {
 		attempt := -rand.Int()
@@ -24,6 +25,58 @@ func TestDelayerAttemptLTEZero(t *testing.T) {
 	}
 }
 
+func TestDecorrelatedJitterDelayer(t *testing.T) {
+	t.Run("zero value", func(t *testing.T) {
+		delayer := &DecorrelatedJitterDelayer{}
+		delay := delayer.Delay(1)
+		if delay <= 0 {
+			t.Fatalf("want delay greater than 0 but get %d", delay)
+		}
+	})
+
+	t.Run("bounded by min and max", func(t *testing.T) {
+		delayer := &DecorrelatedJitterDelayer{
+			Min:        10 * time.Millisecond,
+			Max:        20 * time.Millisecond,
+			Multiplier: 3,
+			Rand:       rand.New(rand.NewSource(1)),
+		}
+		for attempt := 1; attempt <= 20; attempt++ {
+			delay := delayer.Delay(attempt)
+			if delay < delayer.Min || delay > delayer.Max {
+				t.Fatalf("attempt %d: want delay in [%s, %s] but get %s", attempt, delayer.Min, delayer.Max, delay)
+			}
+		}
+	})
+
+	t.Run("reset", func(t *testing.T) {
+		delayer := &DecorrelatedJitterDelayer{
+			Min:        10 * time.Millisecond,
+			Max:        time.Second,
+			Multiplier: 3,
+			Rand:       rand.New(rand.NewSource(1)),
+		}
+		delayer.Delay(1)
+		delayer.Delay(2)
+		delayer.Reset()
+		after := delayer.Delay(1)
+		if after < delayer.Min || after > 3*delayer.Min {
+			t.Fatalf("want first delay after reset in [%s, %s] but get %s", delayer.Min, 3*delayer.Min, after)
+		}
+	})
+
+	t.Run("delay overflow", func(t *testing.T) {
+		delayer := &DecorrelatedJitterDelayer{
+			Min:        math.MaxInt64,
+			Multiplier: 2,
+		}
+		delay := delayer.Delay(1)
+		if delay <= 0 {
+			t.Fatalf("want delay greater than 0 but get %d", delay)
+		}
+	})
+}
+
 func TestExpDelayer(t *testing.T) {
 	t.Run("zero value", func(t *testing.T) {
 		delayer := ExpDelayer{}
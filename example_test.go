@@ -3,6 +3,7 @@ package retry_test
 import (
 	"context"
 	"fmt"
+	"math/rand"
 	"time"
 
 	"github.com/go-camp/retry"
@@ -84,6 +85,62 @@ func ExampleExpDelayer_rand() {
 	// [10s 30s]
 }
 
+func ExampleDecorrelatedJitterDelayer() {
+	delayer := &retry.DecorrelatedJitterDelayer{
+		Min:        time.Second,
+		Max:        20 * time.Second,
+		Multiplier: 3,
+		Rand:       rand.New(rand.NewSource(1)),
+	}
+	expDelayer := retry.ExpDelayer{
+		Initial:    time.Second,
+		Multiplier: 2,
+		Max:        20 * time.Second,
+		Rand:       50,
+	}
+	for attempt := 1; attempt <= 5; attempt++ {
+		jitter := delayer.Delay(attempt)
+		exp := expDelayer.Delay(attempt)
+		inRange := jitter >= delayer.Min && jitter <= delayer.Max
+		fmt.Println(inRange, jitter != exp)
+	}
+	// Output:
+	// true true
+	// true true
+	// true true
+	// true true
+	// true true
+}
+
+// exampleClock is a retry.Clock that advances instantly, so examples
+// covering time-based behavior such as MaxElapsedTime don't pay real
+// delays. It mirrors fakeClock in retry_test.go, redefined here because
+// that one is unexported and lives in the internal test package.
+type exampleClock struct {
+	now time.Time
+}
+
+func (c *exampleClock) Now() time.Time {
+	return c.now
+}
+
+func (c *exampleClock) NewTimer(d time.Duration) retry.Timer {
+	c.now = c.now.Add(d)
+	return exampleTimer{}
+}
+
+type exampleTimer struct{}
+
+func (exampleTimer) C() <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	ch <- time.Time{}
+	return ch
+}
+
+func (exampleTimer) Stop() bool {
+	return true
+}
+
 func printRetryResult(result retry.RetryResult) {
 	fmt.Printf("%+v\n", result)
 	fmt.Println("result.FinalOperationError:", result.FinalOperationError())
@@ -175,6 +232,10 @@ func ExampleRetryer_Retry_ctxCanceled() {
 	// result.FinalAttemptError: context canceled
 }
 
+// ExampleRetryer_Retry_ctxCanceled2 races a real cancellation against a
+// real delay, so it keeps paying real time: Clock only virtualizes the
+// sleep between attempts, not the context package's own timers, and the
+// context.Context here has no pluggable clock to swap in.
 func ExampleRetryer_Retry_ctxCanceled2() {
 	ctx, cancel := context.WithCancel(context.Background())
 	go func() {
@@ -200,6 +261,186 @@ func ExampleRetryer_Retry_ctxCanceled2() {
 	// result.FinalAttemptError: context canceled
 }
 
+func ExampleRetryer_Retry_isRetryable() {
+	retryer := retry.Retryer{
+		Delayer:     retry.NopDelayer{},
+		MaxAttempts: 3,
+		IsRetryable: func(err error) bool {
+			return err.Error() != "err2"
+		},
+	}
+	attempt := 0
+	result := retryer.Retry(
+		context.Background(),
+		func(context.Context) error {
+			attempt++
+			return fmt.Errorf("err%d", attempt)
+		},
+	)
+	printRetryResult(result)
+	// Output:
+	// {MaxAttempts:3 Attempts:[{Delay:0s ContextError:<nil> OperationError:err1} {Delay:0s ContextError:<nil> OperationError:err2}]}
+	// result.FinalOperationError: err2
+	// result.FinalAttemptError: err2
+}
+
+func ExampleRetryer_Retry_isFailure() {
+	retryer := retry.Retryer{
+		Delayer:     retry.NopDelayer{},
+		MaxAttempts: 3,
+		IsFailure: func(err error) bool {
+			return err.Error() != "err2"
+		},
+	}
+	attempt := 0
+	result := retryer.Retry(
+		context.Background(),
+		func(context.Context) error {
+			attempt++
+			return fmt.Errorf("err%d", attempt)
+		},
+	)
+	printRetryResult(result)
+	// Output:
+	// {MaxAttempts:3 Attempts:[{Delay:0s ContextError:<nil> OperationError:err1} {Delay:0s ContextError:<nil> OperationError:<nil>}]}
+	// result.FinalOperationError: <nil>
+	// result.FinalAttemptError: <nil>
+}
+
+func ExampleRetryer_Retry_maxElapsedTime() {
+	// MaxElapsedTime bookkeeping and the delay between attempts both go
+	// through Clock, so this example runs on a virtual clock instead of
+	// paying 2ms of real sleep per attempt.
+	retryer := retry.Retryer{
+		Clock:          &exampleClock{},
+		Delayer:        retry.ConstantDelayer{Duration: 2 * time.Millisecond},
+		MaxAttempts:    5,
+		MaxElapsedTime: 3 * time.Millisecond,
+	}
+	attempt := 0
+	result := retryer.Retry(
+		context.Background(),
+		func(context.Context) error {
+			attempt++
+			return fmt.Errorf("err%d", attempt)
+		},
+	)
+	printRetryResult(result)
+	// Output:
+	// {MaxAttempts:5 Attempts:[{Delay:0s ContextError:<nil> OperationError:err1} {Delay:2ms ContextError:<nil> OperationError:err2} {Delay:2ms ContextError:<nil> OperationError:retry: max elapsed time exceeded}]}
+	// result.FinalOperationError: retry: max elapsed time exceeded
+	// result.FinalAttemptError: retry: max elapsed time exceeded
+}
+
+// ExampleRetryer_Retry_perAttemptTimeout demonstrates PerAttemptTimeout,
+// which derives its child context with context.WithTimeout. That deadline
+// is tracked by the context package's own real timer, not by Clock, so
+// this example keeps paying real time.
+func ExampleRetryer_Retry_perAttemptTimeout() {
+	retryer := retry.Retryer{
+		Delayer:           retry.NopDelayer{},
+		MaxAttempts:       2,
+		PerAttemptTimeout: time.Millisecond,
+	}
+	result := retryer.Retry(
+		context.Background(),
+		func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	)
+	printRetryResult(result)
+	// Output:
+	// {MaxAttempts:2 Attempts:[{Delay:0s ContextError:<nil> OperationError:context deadline exceeded} {Delay:0s ContextError:<nil> OperationError:context deadline exceeded}]}
+	// result.FinalOperationError: context deadline exceeded
+	// result.FinalAttemptError: context deadline exceeded
+}
+
+func ExampleRetryValue() {
+	retryer := retry.Retryer{
+		Delayer:     retry.NopDelayer{},
+		MaxAttempts: 3,
+	}
+	attempt := 0
+	value, result := retry.RetryValue(
+		context.Background(),
+		retryer,
+		func(context.Context) (string, error) {
+			attempt++
+			if attempt > 1 {
+				return "ok", nil
+			}
+			return "", fmt.Errorf("err%d", attempt)
+		},
+	)
+	fmt.Println(value)
+	fmt.Println("result.FinalOperationError:", result.FinalOperationError())
+	// Output:
+	// ok
+	// result.FinalOperationError: <nil>
+}
+
+func ExampleRetryFunc() {
+	retryer := retry.Retryer{
+		Delayer:     retry.NopDelayer{},
+		MaxAttempts: 3,
+	}
+	attempt := 0
+	_, err := retry.RetryFunc(
+		context.Background(),
+		retryer,
+		func(context.Context) error {
+			attempt++
+			return retry.Break(fmt.Errorf("err%d", attempt))
+		},
+	)
+	fmt.Println(err)
+	// Output:
+	// err1
+}
+
+func ExampleRetryAny() {
+	retryer := retry.Retryer{
+		Delayer:     retry.NopDelayer{},
+		MaxAttempts: 3,
+	}
+	result := retry.RetryAny(
+		context.Background(),
+		retryer,
+		func(context.Context) error { return fmt.Errorf("replica1 down") },
+		func(context.Context) error { return nil },
+		func(context.Context) error { return fmt.Errorf("replica3 down") },
+	)
+	fmt.Println(result.FinalOperationError())
+	// Output:
+	// <nil>
+}
+
+func ExampleRetryAll() {
+	retryer := retry.Retryer{
+		Delayer:     retry.NopDelayer{},
+		MaxAttempts: 1,
+	}
+	results := retry.RetryAll(
+		context.Background(),
+		retryer,
+		func(context.Context) error { return nil },
+		func(context.Context) error { return fmt.Errorf("replica2 down") },
+	)
+	for i, result := range results {
+		fmt.Println(i, result.FinalOperationError())
+	}
+	// Output:
+	// 0 <nil>
+	// 1 replica2 down
+}
+
+// ExampleRetryer_Retry_ctxTimeout races a real context deadline against a
+// real delay. Clock virtualizes the wait between attempts, but the
+// deadline itself is tracked by context.WithTimeout's own real timer, so
+// swapping in a virtual Clock here would make the delay resolve before
+// the deadline ever has a chance to fire, changing the very behavior the
+// example demonstrates. This one is intentionally left on real time.
 func ExampleRetryer_Retry_ctxTimeout() {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
 	defer cancel()
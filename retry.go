@@ -13,8 +13,53 @@ type Retryer struct {
 	// MaxAttempts is the maximum number of calls to op.
 	// MaxAttempts is 0 means there is no constraint on the number of attempts.
 	MaxAttempts int
+	// IsRetryable reports whether a non-nil operation error should be
+	// retried. It is consulted before the BreakError check, so it can
+	// veto a retry without the caller needing to wrap the error in
+	// Break. If IsRetryable is nil, every non-nil error is retryable.
+	IsRetryable func(error) bool
+	// IsFailure reports whether a non-nil operation error should count
+	// as a failed attempt. Returning false treats the error as success,
+	// which is useful for errors that should stop the retry loop
+	// without being retryable or recorded as a failure, such as an
+	// HTTP 4xx response. If IsFailure is nil, every non-nil error is a
+	// failure.
+	IsFailure func(error) bool
+	// MaxElapsedTime bounds the total wall-clock time spent across all
+	// attempts and delays. It is checked before each call to operation
+	// after the first, and before each delay; once exceeded, Retry
+	// gives up and records a final Attempt whose OperationError is
+	// ErrMaxElapsedTime. The first attempt always runs regardless of
+	// MaxElapsedTime, consistent with Retry calling operation at least
+	// once. MaxElapsedTime is 0 means there is no constraint on
+	// elapsed time.
+	MaxElapsedTime time.Duration
+	// PerAttemptTimeout, if greater than 0, bounds a single call to
+	// operation by deriving a child context with context.WithTimeout.
+	// A per-attempt timeout surfaces as a normal OperationError and
+	// does not by itself stop the retry loop.
+	PerAttemptTimeout time.Duration
+	// Clock is the time source used for MaxElapsedTime bookkeeping and
+	// for waiting out delays between attempts.
+	// If Clock is nil, a real-time Clock backed by the time package is used.
+	Clock Clock
+	// OnAttempt, if non-nil, is called after every call to operation
+	// with the 1-based attempt number and the error operation
+	// returned (nil on success).
+	OnAttempt func(ctx context.Context, attempt int, err error)
+	// OnDelay, if non-nil, is called before Retry waits out the delay
+	// computed for the next attempt.
+	OnDelay func(ctx context.Context, attempt int, delay time.Duration)
+	// OnGiveUp, if non-nil, is called once, after the final attempt,
+	// when Retry is about to return a RetryResult that did not
+	// succeed. It is not called after a successful Retry.
+	OnGiveUp func(ctx context.Context, result RetryResult)
 }
 
+// ErrMaxElapsedTime is recorded as the OperationError of the final
+// Attempt when Retryer.MaxElapsedTime is exceeded.
+var ErrMaxElapsedTime = errors.New("retry: max elapsed time exceeded")
+
 // BreakError indicates that the operation should not be retried.
 type BreakError struct {
 	Err error
@@ -48,6 +93,13 @@ func (r Retryer) delayer() Delayer {
 	return r.Delayer
 }
 
+func (r Retryer) clock() Clock {
+	if r.Clock == nil {
+		return defaultClock
+	}
+	return r.Clock
+}
+
 type RetryResult struct {
 	MaxAttempts int
 	Attempts    []Attempt
@@ -99,6 +151,10 @@ func (r Retryer) Retry(ctx context.Context, operation func(context.Context) erro
 	var err error
 	maxAttempts := r.MaxAttempts
 	delayer := r.delayer()
+	if rst, ok := delayer.(Resettable); ok {
+		rst.Reset()
+	}
+	clock := r.clock()
 
 	result := RetryResult{MaxAttempts: maxAttempts}
 	var attempt Attempt
@@ -106,13 +162,49 @@ func (r Retryer) Retry(ctx context.Context, operation func(context.Context) erro
 		result.Attempts = append(result.Attempts, attempt)
 	}
 
+	var start time.Time
+	if r.MaxElapsedTime > 0 {
+		start = clock.Now()
+	}
+	elapsed := func() bool {
+		return r.MaxElapsedTime > 0 && clock.Now().Sub(start) >= r.MaxElapsedTime
+	}
+
 	for {
-		err = operation(ctx)
+		if len(result.Attempts) > 0 && elapsed() {
+			attempt.OperationError = ErrMaxElapsedTime
+			appendAttempt()
+			break
+		}
+
+		opCtx := ctx
+		var cancel context.CancelFunc
+		if r.PerAttemptTimeout > 0 {
+			opCtx, cancel = context.WithTimeout(ctx, r.PerAttemptTimeout)
+		}
+		err = operation(opCtx)
+		if cancel != nil {
+			cancel()
+		}
+		if r.OnAttempt != nil {
+			r.OnAttempt(ctx, len(result.Attempts)+1, err)
+		}
 		if err == nil {
 			appendAttempt()
 			break
 		}
 
+		if r.IsFailure != nil && !r.IsFailure(err) {
+			appendAttempt()
+			break
+		}
+
+		if r.IsRetryable != nil && !r.IsRetryable(err) {
+			attempt.OperationError = err
+			appendAttempt()
+			break
+		}
+
 		var berr *BreakError
 		if errors.As(err, &berr) {
 			attempt.OperationError = berr.Err
@@ -126,25 +218,40 @@ func (r Retryer) Retry(ctx context.Context, operation func(context.Context) erro
 			break
 		}
 
+		if elapsed() {
+			attempt = Attempt{OperationError: ErrMaxElapsedTime}
+			appendAttempt()
+			break
+		}
+
 		d := delayer.Delay(len(result.Attempts))
+		if r.OnDelay != nil {
+			r.OnDelay(ctx, len(result.Attempts), d)
+		}
 		attempt = Attempt{Delay: d}
-		if err = sleep(ctx, d); err != nil {
+		if err = sleep(ctx, clock, d); err != nil {
 			attempt.ContextError = err
 			appendAttempt()
 			break
 		}
 	}
 
+	if r.OnGiveUp != nil && len(result.Attempts) > 0 {
+		if last := result.Attempts[len(result.Attempts)-1]; last.OperationError != nil || last.ContextError != nil {
+			r.OnGiveUp(ctx, result)
+		}
+	}
+
 	return result
 }
 
-func sleep(ctx context.Context, d time.Duration) error {
-	t := time.NewTimer(d)
+func sleep(ctx context.Context, clock Clock, d time.Duration) error {
+	t := clock.NewTimer(d)
 	defer t.Stop()
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
-	case <-t.C:
+	case <-t.C():
 		return nil
 	}
 }
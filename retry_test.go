@@ -1,10 +1,129 @@
 package retry
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"testing"
+	"time"
 )
 
+// fakeClock is a Clock that advances instantly, so tests covering
+// time-based behavior such as MaxElapsedTime don't pay real delays.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func (c *fakeClock) NewTimer(d time.Duration) Timer {
+	c.now = c.now.Add(d)
+	return fakeTimer{}
+}
+
+type fakeTimer struct{}
+
+func (fakeTimer) C() <-chan time.Time {
+	ch := make(chan time.Time, 1)
+	ch <- time.Time{}
+	return ch
+}
+
+func (fakeTimer) Stop() bool {
+	return true
+}
+
+func TestRetryerMaxElapsedTimeCallsOperationAtLeastOnce(t *testing.T) {
+	retryer := Retryer{
+		Delayer:        NopDelayer{},
+		MaxElapsedTime: time.Nanosecond,
+	}
+	called := false
+	result := retryer.Retry(context.Background(), func(context.Context) error {
+		called = true
+		return nil
+	})
+	if !called {
+		t.Fatal("want operation to be called at least once")
+	}
+	if err := result.FinalOperationError(); err != nil {
+		t.Fatalf("want nil but get %v", err)
+	}
+}
+
+func TestRetryerCallbacks(t *testing.T) {
+	var attempts []int
+	var delays []time.Duration
+	var gaveUp bool
+
+	retryer := Retryer{
+		Delayer:     NopDelayer{},
+		MaxAttempts: 3,
+		OnAttempt: func(_ context.Context, attempt int, err error) {
+			attempts = append(attempts, attempt)
+		},
+		OnDelay: func(_ context.Context, _ int, delay time.Duration) {
+			delays = append(delays, delay)
+		},
+		OnGiveUp: func(_ context.Context, _ RetryResult) {
+			gaveUp = true
+		},
+	}
+	retryer.Retry(context.Background(), func(context.Context) error {
+		return errors.New("boom")
+	})
+
+	if got := len(attempts); got != 3 {
+		t.Fatalf("want OnAttempt called 3 times but get %d", got)
+	}
+	if got := len(delays); got != 2 {
+		t.Fatalf("want OnDelay called 2 times but get %d", got)
+	}
+	if !gaveUp {
+		t.Fatal("want OnGiveUp to be called")
+	}
+}
+
+func TestRetryerCallbacks_success(t *testing.T) {
+	gaveUp := false
+	retryer := Retryer{
+		Delayer:  NopDelayer{},
+		OnGiveUp: func(context.Context, RetryResult) { gaveUp = true },
+	}
+	retryer.Retry(context.Background(), func(context.Context) error {
+		return nil
+	})
+	if gaveUp {
+		t.Fatal("want OnGiveUp not called after a successful Retry")
+	}
+}
+
+func TestRetryerClock(t *testing.T) {
+	clock := &fakeClock{}
+	retryer := Retryer{
+		Clock:          clock,
+		Delayer:        ConstantDelayer{Duration: time.Hour},
+		MaxAttempts:    5,
+		MaxElapsedTime: 3 * time.Hour,
+	}
+	attempt := 0
+	result := retryer.Retry(
+		context.Background(),
+		func(context.Context) error {
+			attempt++
+			return fmt.Errorf("err%d", attempt)
+		},
+	)
+	if got := len(result.Attempts); got != 4 {
+		t.Fatalf("want 4 attempts but get %d", got)
+	}
+	if !errors.Is(result.FinalOperationError(), ErrMaxElapsedTime) {
+		t.Fatalf("want ErrMaxElapsedTime but get %v", result.FinalOperationError())
+	}
+}
+
 func TestBreak(t *testing.T) {
 	t.Run("nil", func(t *testing.T) {
 		err := Break(nil)
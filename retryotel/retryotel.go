@@ -0,0 +1,110 @@
+// Package retryotel instruments a retry.Retryer with OpenTelemetry
+// spans and metrics, so operators can see retry storms in production
+// rather than inspecting a RetryResult after the fact.
+//
+// This package depends on the OpenTelemetry SDK, which the root
+// retry package does not; import it only if you already take an
+// OpenTelemetry dependency.
+package retryotel
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/go-camp/retry"
+)
+
+// Option configures the instrumentation installed by Decorate.
+type Option func(*config)
+
+type config struct {
+	tracer trace.Tracer
+	meter  metric.Meter
+}
+
+// WithTracer sets the trace.Tracer used to start a span for every
+// attempt and delay. If not set, Decorate does not emit spans.
+func WithTracer(tracer trace.Tracer) Option {
+	return func(c *config) {
+		c.tracer = tracer
+	}
+}
+
+// WithMeter sets the metric.Meter used to record the
+// retry.attempts_total and retry.giveups_total counters. If not set,
+// Decorate does not emit metrics.
+func WithMeter(meter metric.Meter) Option {
+	return func(c *config) {
+		c.meter = meter
+	}
+}
+
+// Decorate returns a copy of r with OnAttempt, OnDelay, and OnGiveUp
+// wired to emit OpenTelemetry spans and metrics. Any callbacks already
+// set on r are preserved and called after the instrumentation.
+func Decorate(r retry.Retryer, opts ...Option) retry.Retryer {
+	var c config
+	for _, opt := range opts {
+		opt(&c)
+	}
+
+	var attemptsTotal, giveupsTotal metric.Int64Counter
+	if c.meter != nil {
+		attemptsTotal, _ = c.meter.Int64Counter("retry.attempts_total")
+		giveupsTotal, _ = c.meter.Int64Counter("retry.giveups_total")
+	}
+
+	onAttempt := r.OnAttempt
+	r.OnAttempt = func(ctx context.Context, attempt int, err error) {
+		if c.tracer != nil {
+			_, span := c.tracer.Start(ctx, "retry.attempt", trace.WithAttributes(
+				attribute.Int("retry.attempt", attempt),
+				attribute.Int("retry.max_attempts", r.MaxAttempts),
+			))
+			if err != nil {
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+			} else {
+				span.SetStatus(codes.Ok, "")
+			}
+			span.End()
+		}
+		if attemptsTotal != nil {
+			attemptsTotal.Add(ctx, 1)
+		}
+		if onAttempt != nil {
+			onAttempt(ctx, attempt, err)
+		}
+	}
+
+	onDelay := r.OnDelay
+	r.OnDelay = func(ctx context.Context, attempt int, delay time.Duration) {
+		if c.tracer != nil {
+			_, span := c.tracer.Start(ctx, "retry.delay", trace.WithAttributes(
+				attribute.Int("retry.attempt", attempt),
+				attribute.Int64("retry.delay_ms", delay.Milliseconds()),
+			))
+			span.End()
+		}
+		if onDelay != nil {
+			onDelay(ctx, attempt, delay)
+		}
+	}
+
+	onGiveUp := r.OnGiveUp
+	r.OnGiveUp = func(ctx context.Context, result retry.RetryResult) {
+		if giveupsTotal != nil {
+			giveupsTotal.Add(ctx, 1)
+		}
+		if onGiveUp != nil {
+			onGiveUp(ctx, result)
+		}
+	}
+
+	return r
+}
@@ -0,0 +1,45 @@
+package retryotel
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/metric/noop"
+	tracenoop "go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/go-camp/retry"
+)
+
+func TestDecorate(t *testing.T) {
+	var gotAttempt, gotDelay, gotGiveUp bool
+	r := retry.Retryer{
+		Delayer:     retry.NopDelayer{},
+		MaxAttempts: 2,
+		OnAttempt:   func(context.Context, int, error) { gotAttempt = true },
+		OnDelay:     func(context.Context, int, time.Duration) { gotDelay = true },
+		OnGiveUp:    func(context.Context, retry.RetryResult) { gotGiveUp = true },
+	}
+
+	tracer := tracenoop.NewTracerProvider().Tracer("retryotel_test")
+	meter := noop.NewMeterProvider().Meter("retryotel_test")
+
+	decorated := Decorate(r, WithTracer(tracer), WithMeter(meter))
+	result := decorated.Retry(context.Background(), func(context.Context) error {
+		return errors.New("boom")
+	})
+
+	if err := result.FinalOperationError(); err == nil {
+		t.Fatal("want a final operation error")
+	}
+	if !gotAttempt {
+		t.Error("want the original OnAttempt callback to still be called")
+	}
+	if !gotDelay {
+		t.Error("want the original OnDelay callback to still be called")
+	}
+	if !gotGiveUp {
+		t.Error("want the original OnGiveUp callback to still be called")
+	}
+}
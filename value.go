@@ -0,0 +1,31 @@
+package retry
+
+import "context"
+
+// RetryFunc retries operation using r and returns the RetryResult
+// alongside its FinalOperationError, for callers that only care about
+// the final error and not the full attempt history.
+func RetryFunc(ctx context.Context, r Retryer, operation func(context.Context) error) (RetryResult, error) {
+	result := r.Retry(ctx, operation)
+	return result, result.FinalOperationError()
+}
+
+// RetryValue retries operation using r and returns the value from its
+// last call alongside the RetryResult, saving callers from closing
+// over a result variable. The value is kept even when that last call
+// also returned an error, since r.IsFailure may classify the error as
+// success (e.g. an HTTP 4xx response whose body callers still want).
+// If operation is never called, the zero value of T is returned.
+//
+// Go does not allow methods to have their own type parameters, so
+// there is no Retryer.DoValue method; RetryValue(ctx, r, op) is the
+// generic equivalent of r.Retry(ctx, op).
+func RetryValue[T any](ctx context.Context, r Retryer, operation func(context.Context) (T, error)) (T, RetryResult) {
+	var value T
+	result := r.Retry(ctx, func(ctx context.Context) error {
+		v, err := operation(ctx)
+		value = v
+		return err
+	})
+	return value, result
+}
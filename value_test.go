@@ -0,0 +1,44 @@
+package retry
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+func TestRetryValue(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		retryer := Retryer{Delayer: NopDelayer{}, MaxAttempts: 3}
+		attempt := 0
+		value, result := RetryValue(context.Background(), retryer, func(context.Context) (int, error) {
+			attempt++
+			if attempt > 1 {
+				return 42, nil
+			}
+			return 0, errors.New("not yet")
+		})
+		if value != 42 {
+			t.Fatalf("want 42 but get %d", value)
+		}
+		if err := result.FinalOperationError(); err != nil {
+			t.Fatalf("want nil but get %v", err)
+		}
+	})
+
+	t.Run("IsFailure treats error as success but keeps the value", func(t *testing.T) {
+		retryer := Retryer{
+			Delayer:     NopDelayer{},
+			MaxAttempts: 3,
+			IsFailure:   func(error) bool { return false },
+		}
+		value, result := RetryValue(context.Background(), retryer, func(context.Context) (int, error) {
+			return 42, errors.New("http 404")
+		})
+		if value != 42 {
+			t.Fatalf("want 42 but get %d", value)
+		}
+		if err := result.FinalOperationError(); err != nil {
+			t.Fatalf("want nil but get %v", err)
+		}
+	})
+}